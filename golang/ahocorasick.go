@@ -0,0 +1,194 @@
+package sz
+
+// ahoCorasickAlphabet is the size of the byte-indexed transition table used by
+// the multi-pattern automaton below. StringZilla targets binary data, so the
+// automaton dispatches on raw bytes rather than runes.
+const ahoCorasickAlphabet = 256
+
+// ahoCorasick is a compiled Aho-Corasick automaton over a fixed set of byte
+// patterns. It backs both Replacer (multi-pattern replacement) and MultiFinder
+// (multi-pattern search), so the goto/fail/output tables are built once here
+// and shared by both call sites.
+//
+// Transitions, failure links, and outputs are stored as flat []int32 slices
+// indexed by node, mirroring how the C side of StringZilla keeps its tables
+// contiguous for cache-friendly scans; there is no per-node map or pointer
+// chasing once the automaton is built.
+type ahoCorasick struct {
+	goTo       []int32 // size numNodes*ahoCorasickAlphabet; goTo[node*256+b] is the next node for byte b, with failure fallback baked in
+	trieGoTo   []int32 // size numNodes*ahoCorasickAlphabet; same shape as goTo, but -1 wherever there is no literal trie edge
+	fail       []int32 // size numNodes; fail[node] is the failure link
+	output     []int32 // size numNodes; index of the pattern ending exactly at node, or -1
+	bestOutput []int32 // size numNodes; index of the longest pattern ending at node (via fail chain), or -1
+	patternLen []int32 // length of each pattern, indexed by pattern id
+}
+
+// newAhoCorasick builds an automaton over patterns. Patterns must be non-empty;
+// callers are expected to special-case empty needles before reaching here.
+// When several patterns share a node (duplicate keys), the earliest one in
+// the slice wins, matching the "first pair wins" tie-break used by Replacer.
+func newAhoCorasick(patterns []string) *ahoCorasick {
+	goTo := make([]int32, ahoCorasickAlphabet)
+	for i := range goTo {
+		goTo[i] = -1
+	}
+	output := []int32{-1}
+	numNodes := int32(1)
+
+	for patternIdx, pattern := range patterns {
+		node := int32(0)
+		for i := 0; i < len(pattern); i++ {
+			idx := int(node)*ahoCorasickAlphabet + int(pattern[i])
+			next := goTo[idx]
+			if next == -1 {
+				next = numNodes
+				numNodes++
+				goTo = append(goTo, make([]int32, ahoCorasickAlphabet)...)
+				for k := 0; k < ahoCorasickAlphabet; k++ {
+					goTo[int(next)*ahoCorasickAlphabet+k] = -1
+				}
+				goTo[idx] = next
+				output = append(output, -1)
+			}
+			node = next
+		}
+		if output[node] == -1 {
+			output[node] = int32(patternIdx)
+		}
+	}
+
+	// trieGoTo is a snapshot of goTo before the BFS below overwrites missing
+	// edges with failure-fallback transitions: matchPrefix needs to tell "no
+	// pattern continues this way" (trieGoTo == -1) apart from "this byte
+	// routes to an unrelated pattern via a failure link" (what goTo would say
+	// instead), since only the former should stop a direct-descent match.
+	trieGoTo := make([]int32, len(goTo))
+	copy(trieGoTo, goTo)
+
+	fail := make([]int32, numNodes)
+	bestOutput := make([]int32, numNodes)
+	bestOutput[0] = output[0]
+
+	queue := make([]int32, 0, numNodes)
+	for b := 0; b < ahoCorasickAlphabet; b++ {
+		child := goTo[b]
+		if child == -1 {
+			goTo[b] = 0
+			continue
+		}
+		fail[child] = 0
+		bestOutput[child] = output[child]
+		queue = append(queue, child)
+	}
+
+	for qi := 0; qi < len(queue); qi++ {
+		node := queue[qi]
+		for b := 0; b < ahoCorasickAlphabet; b++ {
+			idx := int(node)*ahoCorasickAlphabet + b
+			child := goTo[idx]
+			failTransition := goTo[int(fail[node])*ahoCorasickAlphabet+b]
+			if child == -1 {
+				goTo[idx] = failTransition
+				continue
+			}
+			fail[child] = failTransition
+			if output[child] != -1 {
+				bestOutput[child] = output[child]
+			} else {
+				bestOutput[child] = bestOutput[failTransition]
+			}
+			queue = append(queue, child)
+		}
+	}
+
+	patternLen := make([]int32, len(patterns))
+	for i, p := range patterns {
+		patternLen[i] = int32(len(p))
+	}
+
+	return &ahoCorasick{goTo: goTo, trieGoTo: trieGoTo, fail: fail, output: output, bestOutput: bestOutput, patternLen: patternLen}
+}
+
+// step advances the automaton from state on byte b and returns the new state.
+func (a *ahoCorasick) step(state int32, b byte) int32 {
+	return a.goTo[int(state)*ahoCorasickAlphabet+int(b)]
+}
+
+// matchPrefix finds the pattern that should win if the scan commits to a
+// match starting at pos: among every pattern that is a prefix of s[pos:], it
+// picks the one with the lowest pattern index (the earliest old string
+// passed to NewReplacer, or needle passed to NewMultiFinder), not the
+// longest one. That's what makes two patterns in a prefix relationship (e.g.
+// "a" and "ab") resolve by argument order instead of by whichever happens to
+// complete first, matching strings.Replacer's own tie-break.
+//
+// The walk only follows literal trie edges (trieGoTo), never a failure-link
+// fallback: falling back would jump to an unrelated pattern rather than
+// continuing the one actually being matched against s from pos.
+func (a *ahoCorasick) matchPrefix(s string, pos int) (patternIdx int, length int, ok bool) {
+	node := int32(0)
+	bestIdx := int32(-1)
+	bestLen := 0
+	for i := pos; i < len(s); i++ {
+		next := a.trieGoTo[int(node)*ahoCorasickAlphabet+int(s[i])]
+		if next == -1 {
+			break
+		}
+		node = next
+		if out := a.output[node]; out != -1 && (bestIdx == -1 || out < bestIdx) {
+			bestIdx = out
+			bestLen = i + 1 - pos
+		}
+	}
+	if bestIdx == -1 {
+		return 0, 0, false
+	}
+	return int(bestIdx), bestLen, true
+}
+
+// scan walks s, calling fn(start, end, patternIdx) for the priority match (see
+// matchPrefix) starting at each position, left to right and non-overlapping:
+// it advances past a match before looking for the next one. fn returning
+// false stops the scan early.
+//
+// This is a direct trie descent per position rather than a single automaton
+// pass, so it costs O(len(s) * longest pattern sharing a prefix) instead of
+// O(len(s)) in the worst case - the price of the priority tie-break above,
+// which a single failure-link pass can't express. scanOverlapping below has
+// no such tie-break to make and stays a single O(len(s)) pass.
+func (a *ahoCorasick) scan(s string, fn func(start, end int, patternIdx int) bool) {
+	pos := 0
+	for pos < len(s) {
+		patternIdx, length, ok := a.matchPrefix(s, pos)
+		if !ok {
+			pos++
+			continue
+		}
+		if !fn(pos, pos+length, patternIdx) {
+			return
+		}
+		pos += length
+	}
+}
+
+// scanOverlapping walks s once, calling fn for every match the automaton finds,
+// including ones nested inside or overlapping with earlier matches. The
+// automaton state is never reset, so it reports every occurrence of every
+// pattern ending at each position.
+func (a *ahoCorasick) scanOverlapping(s string, fn func(start, end int, patternIdx int) bool) {
+	state := int32(0)
+	for i := 0; i < len(s); i++ {
+		state = a.step(state, s[i])
+		for node := state; node != 0 && a.bestOutput[node] != -1; {
+			pat := a.output[node]
+			if pat != -1 {
+				length := int(a.patternLen[pat])
+				end := i + 1
+				if !fn(end-length, end, int(pat)) {
+					return
+				}
+			}
+			node = a.fail[node]
+		}
+	}
+}
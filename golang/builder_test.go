@@ -0,0 +1,91 @@
+package sz_test
+
+import (
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestBuilderBasics cross-checks Write/WriteString/WriteByte/WriteRune
+// against strings.Builder.
+func TestBuilderBasics(t *testing.T) {
+	var got sz.Builder
+	var want strings.Builder
+
+	got.WriteString("hello ")
+	want.WriteString("hello ")
+	got.Write([]byte("world"))
+	want.Write([]byte("world"))
+	got.WriteByte('!')
+	want.WriteByte('!')
+	got.WriteRune('é')
+	want.WriteRune('é')
+
+	if got.String() != want.String() {
+		t.Fatalf("Builder = %q, want %q", got.String(), want.String())
+	}
+	if got.Len() != want.Len() {
+		t.Fatalf("Len() = %d, want %d", got.Len(), want.Len())
+	}
+
+	got.Reset()
+	if got.Len() != 0 || got.String() != "" {
+		t.Fatalf("after Reset: Len()=%d String()=%q, want 0, \"\"", got.Len(), got.String())
+	}
+}
+
+// TestBuilderAppendJoin verifies AppendJoin matches strings.Join.
+func TestBuilderAppendJoin(t *testing.T) {
+	var b sz.Builder
+	b.AppendJoin(", ", "alpha", "beta", "gamma")
+	if want := strings.Join([]string{"alpha", "beta", "gamma"}, ", "); b.String() != want {
+		t.Fatalf("AppendJoin = %q, want %q", b.String(), want)
+	}
+}
+
+// TestBuilderAppendRepeat verifies AppendRepeat matches strings.Repeat,
+// including the zero-count and empty-string edge cases.
+func TestBuilderAppendRepeat(t *testing.T) {
+	tests := []struct {
+		s string
+		n int
+	}{
+		{"ab", 5},
+		{"x", 0},
+		{"", 3},
+		{"abc", 1},
+	}
+	for _, tt := range tests {
+		var b sz.Builder
+		b.AppendRepeat(tt.s, tt.n)
+		if want := strings.Repeat(tt.s, tt.n); b.String() != want {
+			t.Errorf("AppendRepeat(%q, %d) = %q, want %q", tt.s, tt.n, b.String(), want)
+		}
+	}
+}
+
+// TestBuilderAppendReplaceAll verifies AppendReplaceAll matches
+// strings.ReplaceAll for a single old/new pair.
+func TestBuilderAppendReplaceAll(t *testing.T) {
+	var b sz.Builder
+	b.AppendReplaceAll("foobazfoo", "foo", "bar")
+	if want := strings.ReplaceAll("foobazfoo", "foo", "bar"); b.String() != want {
+		t.Fatalf("AppendReplaceAll = %q, want %q", b.String(), want)
+	}
+}
+
+// TestBuilderCopyPanics verifies the Builder panics on illegal copy-by-value
+// after first use, matching strings.Builder's safeguard.
+func TestBuilderCopyPanics(t *testing.T) {
+	var b sz.Builder
+	b.WriteString("x")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on copied Builder")
+		}
+	}()
+	bCopy := b
+	bCopy.WriteString("y")
+}
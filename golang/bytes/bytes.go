@@ -0,0 +1,230 @@
+// Package szbytes mirrors the stringzilla/golang package sz, but operates on
+// []byte instead of string. It exists so that callers whose data already
+// lives in a []byte (network buffers, bufio.Reader.Peek, mmap'd files) never
+// have to pay for a string(b) copy just to reach the SIMD-accelerated scans -
+// the same relationship the standard library's bytes package has to strings.
+//
+// https://pkg.go.dev/bytes
+package szbytes
+
+// #cgo CFLAGS: -O3 -mno-red-zone -I../../include -DSZ_DYNAMIC_DISPATCH=1
+// #cgo LDFLAGS: -L. -L/usr/local/lib -L../../build_golang -L../../build_release -L../../build_shared -lstringzilla_shared
+// #cgo noescape sz_find
+// #cgo nocallback sz_find
+// #cgo noescape sz_find_byte
+// #cgo nocallback sz_find_byte
+// #cgo noescape sz_rfind
+// #cgo nocallback sz_rfind
+// #cgo noescape sz_rfind_byte
+// #cgo nocallback sz_rfind_byte
+// #cgo noescape sz_find_byte_from
+// #cgo nocallback sz_find_byte_from
+// #cgo noescape sz_rfind_byte_from
+// #cgo nocallback sz_rfind_byte_from
+// #cgo noescape sz_bytesum
+// #cgo nocallback sz_bytesum
+// #cgo noescape sz_hash
+// #cgo nocallback sz_hash
+// #define SZ_DYNAMIC_DISPATCH 1
+// #include <stringzilla/stringzilla.h>
+import "C"
+import (
+	"bytes"
+	"unsafe"
+)
+
+func init() {
+	// Mirrors the manual dispatch-table kick in the sz package: cgo's internal
+	// linker doesn't always run the C side's __attribute__((constructor)).
+	C.sz_dispatch_table_init()
+}
+
+// emptySentinel is a valid, non-nil address cBytes can hand to C for an empty
+// slice: the request behind this package called out a nil pointer as invalid
+// C input even with a zero length, so every call below always gets a real
+// pointer to dereference-if-it-has-to, never nil.
+var emptySentinel byte
+
+// cBytes returns a C pointer to the first byte of b, or to emptySentinel for
+// an empty slice.
+func cBytes(b []byte) *C.char {
+	if len(b) == 0 {
+		return (*C.char)(unsafe.Pointer(&emptySentinel))
+	}
+	return (*C.char)(unsafe.Pointer(unsafe.SliceData(b)))
+}
+
+// Contains reports whether subslice is within b.
+// https://pkg.go.dev/bytes#Contains
+func Contains(b []byte, subslice []byte) bool {
+	matchPtr := unsafe.Pointer(C.sz_find(cBytes(b), C.ulong(len(b)), cBytes(subslice), C.ulong(len(subslice))))
+	return matchPtr != nil
+}
+
+// Index returns the index of the first instance of subslice in b, or -1 if
+// subslice is not present.
+// https://pkg.go.dev/bytes#Index
+func Index(b []byte, subslice []byte) int64 {
+	if len(subslice) == 0 {
+		return 0
+	}
+	if len(b) < len(subslice) {
+		return -1
+	}
+	strPtr := cBytes(b)
+	matchPtr := unsafe.Pointer(C.sz_find(strPtr, C.ulong(len(b)), cBytes(subslice), C.ulong(len(subslice))))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// LastIndex returns the index of the last instance of subslice in b, or -1 if
+// subslice is not present.
+// https://pkg.go.dev/bytes#LastIndex
+func LastIndex(b []byte, subslice []byte) int64 {
+	if len(subslice) == 0 {
+		return int64(len(b))
+	}
+	if len(b) < len(subslice) {
+		return -1
+	}
+	strPtr := cBytes(b)
+	matchPtr := unsafe.Pointer(C.sz_rfind(strPtr, C.ulong(len(b)), cBytes(subslice), C.ulong(len(subslice))))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// IndexByte returns the index of the first instance of c in b, or -1 if c is
+// not present.
+// https://pkg.go.dev/bytes#IndexByte
+func IndexByte(b []byte, c byte) int64 {
+	strPtr := cBytes(b)
+	cPtr := (*C.char)(unsafe.Pointer(&c))
+	matchPtr := unsafe.Pointer(C.sz_find_byte(strPtr, C.ulong(len(b)), cPtr))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// LastIndexByte returns the index of the last instance of c in b, or -1 if c
+// is not present.
+// https://pkg.go.dev/bytes#LastIndexByte
+func LastIndexByte(b []byte, c byte) int64 {
+	strPtr := cBytes(b)
+	cPtr := (*C.char)(unsafe.Pointer(&c))
+	matchPtr := unsafe.Pointer(C.sz_rfind_byte(strPtr, C.ulong(len(b)), cPtr))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// IndexAny returns the index of the first instance of any byte from charset
+// in b, or -1 if none are present.
+// Note: This is byte-set based, not Unicode rune semantics like bytes.IndexAny.
+// https://pkg.go.dev/bytes#IndexAny
+func IndexAny(b []byte, charset []byte) int64 {
+	strPtr := cBytes(b)
+	matchPtr := unsafe.Pointer(C.sz_find_byte_from(strPtr, C.ulong(len(b)), cBytes(charset), C.ulong(len(charset))))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// LastIndexAny returns the index of the last instance of any byte from
+// charset in b, or -1 if none are present.
+// Note: This is byte-set based, not Unicode rune semantics like bytes.LastIndexAny.
+// https://pkg.go.dev/bytes#LastIndexAny
+func LastIndexAny(b []byte, charset []byte) int64 {
+	strPtr := cBytes(b)
+	matchPtr := unsafe.Pointer(C.sz_rfind_byte_from(strPtr, C.ulong(len(b)), cBytes(charset), C.ulong(len(charset))))
+	if matchPtr == nil {
+		return -1
+	}
+	return int64(uintptr(matchPtr) - uintptr(unsafe.Pointer(strPtr)))
+}
+
+// Count returns the number of overlapping or non-overlapping instances of
+// subslice in b. If subslice is empty, Count returns 1 + len(b).
+// https://pkg.go.dev/bytes#Count
+func Count(b []byte, subslice []byte, overlap bool) int64 {
+	strPtr := cBytes(b)
+	strLen := int64(len(b))
+	substrPtr := cBytes(subslice)
+	substrLen := int64(len(subslice))
+
+	if substrLen == 0 {
+		return 1 + strLen
+	}
+	if strLen == 0 || strLen < substrLen {
+		return 0
+	}
+
+	count := int64(0)
+	if overlap {
+		for strLen > 0 {
+			matchPtr := unsafe.Pointer(C.sz_find(strPtr, C.ulong(strLen), substrPtr, C.ulong(substrLen)))
+			if matchPtr == nil {
+				break
+			}
+			count++
+			strLen -= 1 + int64(uintptr(matchPtr)-uintptr(unsafe.Pointer(strPtr)))
+			strPtr = (*C.char)(unsafe.Add(matchPtr, 1))
+		}
+	} else {
+		for strLen > 0 {
+			matchPtr := unsafe.Pointer(C.sz_find(strPtr, C.ulong(strLen), substrPtr, C.ulong(substrLen)))
+			if matchPtr == nil {
+				break
+			}
+			count++
+			strLen -= substrLen + int64(uintptr(matchPtr)-uintptr(unsafe.Pointer(strPtr)))
+			strPtr = (*C.char)(unsafe.Add(matchPtr, substrLen))
+		}
+	}
+
+	return count
+}
+
+// Equal reports whether a and b are the same length and contain the same
+// bytes. There is no sz_compare entry point in the C library to call into
+// here, so Equal is a straight pass-through to the standard library.
+// https://pkg.go.dev/bytes#Equal
+func Equal(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
+// Compare returns an integer comparing two byte slices lexicographically.
+// There is no sz_compare entry point in the C library to call into here, so
+// Compare is a straight pass-through to the standard library.
+// https://pkg.go.dev/bytes#Compare
+func Compare(a, b []byte) int {
+	return bytes.Compare(a, b)
+}
+
+// HasPrefix reports whether b begins with prefix.
+// https://pkg.go.dev/bytes#HasPrefix
+func HasPrefix(b, prefix []byte) bool {
+	return len(b) >= len(prefix) && Equal(b[:len(prefix)], prefix)
+}
+
+// HasSuffix reports whether b ends with suffix.
+// https://pkg.go.dev/bytes#HasSuffix
+func HasSuffix(b, suffix []byte) bool {
+	return len(b) >= len(suffix) && Equal(b[len(b)-len(suffix):], suffix)
+}
+
+// Bytesum computes a simple 64-bit checksum by summing bytes.
+func Bytesum(b []byte) uint64 {
+	return uint64(C.sz_bytesum(cBytes(b), C.ulong(len(b))))
+}
+
+// Hash computes a 64-bit non-cryptographic hash of b with a seed.
+func Hash(b []byte, seed uint64) uint64 {
+	return uint64(C.sz_hash(cBytes(b), C.ulong(len(b)), (C.sz_u64_t)(seed)))
+}
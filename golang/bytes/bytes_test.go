@@ -0,0 +1,103 @@
+package szbytes_test
+
+import (
+	"bytes"
+	"testing"
+
+	szbytes "github.com/ashvardanian/stringzilla/golang/bytes"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		b, sub string
+		want   bool
+	}{
+		{"test", "s", true},
+		{"test", "test", true},
+		{"test", "zest", false},
+	}
+	for _, tt := range tests {
+		if got := szbytes.Contains([]byte(tt.b), []byte(tt.sub)); got != tt.want {
+			t.Errorf("Contains(%q, %q) = %v, want %v", tt.b, tt.sub, got, tt.want)
+		}
+	}
+}
+
+func TestIndexAndLastIndex(t *testing.T) {
+	tests := []struct{ b, sub string }{
+		{"test", "t"},
+		{"test", "s"},
+		{"test", "z"},
+		{"test", ""},
+	}
+	for _, tt := range tests {
+		if got, want := szbytes.Index([]byte(tt.b), []byte(tt.sub)), int64(bytes.Index([]byte(tt.b), []byte(tt.sub))); got != want {
+			t.Errorf("Index(%q, %q) = %d, want %d", tt.b, tt.sub, got, want)
+		}
+		if got, want := szbytes.LastIndex([]byte(tt.b), []byte(tt.sub)), int64(bytes.LastIndex([]byte(tt.b), []byte(tt.sub))); got != want {
+			t.Errorf("LastIndex(%q, %q) = %d, want %d", tt.b, tt.sub, got, want)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		b, sub  string
+		overlap bool
+		want    int64
+	}{
+		{"aaaaa", "a", false, 5},
+		{"aaaaa", "aa", false, 2},
+		{"aaaaa", "aa", true, 4},
+	}
+	for _, tt := range tests {
+		if got := szbytes.Count([]byte(tt.b), []byte(tt.sub), tt.overlap); got != tt.want {
+			t.Errorf("Count(%q, %q, %v) = %d, want %d", tt.b, tt.sub, tt.overlap, got, tt.want)
+		}
+	}
+}
+
+func TestEqualAndCompare(t *testing.T) {
+	tests := []struct{ a, b string }{
+		{"abc", "abc"},
+		{"abc", "abd"},
+		{"abc", "ab"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got, want := szbytes.Equal([]byte(tt.a), []byte(tt.b)), bytes.Equal([]byte(tt.a), []byte(tt.b)); got != want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, want)
+		}
+		got, want := szbytes.Compare([]byte(tt.a), []byte(tt.b)), bytes.Compare([]byte(tt.a), []byte(tt.b))
+		if (got < 0) != (want < 0) || (got > 0) != (want > 0) || (got == 0) != (want == 0) {
+			t.Errorf("Compare(%q, %q) = %d, want sign matching %d", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestHasPrefixAndSuffix(t *testing.T) {
+	b := []byte("hello world")
+	if !szbytes.HasPrefix(b, []byte("hello")) {
+		t.Error("HasPrefix should be true")
+	}
+	if szbytes.HasPrefix(b, []byte("world")) {
+		t.Error("HasPrefix should be false")
+	}
+	if !szbytes.HasSuffix(b, []byte("world")) {
+		t.Error("HasSuffix should be true")
+	}
+	if szbytes.HasSuffix(b, []byte("hello")) {
+		t.Error("HasSuffix should be false")
+	}
+}
+
+func TestAllocsPerRun(t *testing.T) {
+	haystack := []byte("the quick brown fox jumps over the lazy dog")
+	needle := []byte("fox")
+	allocs := testing.AllocsPerRun(100, func() {
+		szbytes.Index(haystack, needle)
+	})
+	if allocs != 0 {
+		t.Errorf("Index allocated %.0f times per run, want 0", allocs)
+	}
+}
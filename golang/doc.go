@@ -0,0 +1,26 @@
+// StringZilla is a SIMD-accelerated string library modern CPUs, written in C 99,
+// and using AVX2, AVX512, Arm NEON, and SVE intrinsics to accelerate processing.
+//
+// The GoLang binding is intended to provide a simple interface to a precompiled
+// shared library, available on GitHub: https://github.com/ashvardanian/stringzilla
+//
+// It requires Go 1.24 or newer to leverage the `cGo` `noescape` and `nocallback`
+// directives. Without those the latency of calling C functions from Go is too high
+// to be useful for string processing.
+//
+// Unlike the native Go `strings` package, StringZilla primarily targets byte-level
+// binary data processing, with less emphasis on UTF-8 and locale-specific tasks.
+//
+// When cgo is unavailable - cross-compilation, Windows without the DLL on
+// PATH, WASM, CGO_ENABLED=0 binaries, Alpine minimal images - or when built
+// with the sz_purego tag, every exported function falls back to a pure-Go
+// implementation backed by the standard library, at the cost of losing the
+// SIMD speedup. See lib_purego.go for that fallback and lib_cgo.go for the
+// accelerated default. One fallback is not behavior-equivalent: Hash (and
+// Hasher) is a scope cut rather than a port of StringZilla's own sz_hash,
+// and its digests will not match between the two builds - see the doc
+// comment on lib_purego.go's Hash for why.
+//
+// []byte entry points for these same operations live in the golang/bytes
+// package (package szbytes), not here - see that package's doc comment.
+package sz
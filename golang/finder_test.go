@@ -0,0 +1,193 @@
+package sz_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// naiveFindAll finds every non-overlapping (or, if overlap, every
+// overlapping) occurrence of pat in hay using strings.Index in a loop, as
+// the reference implementation Finder and MultiFinder are checked against.
+func naiveFindAll(hay, pat string, overlap bool) []int64 {
+	var positions []int64
+	pos := 0
+	for pos <= len(hay)-len(pat) {
+		idx := strings.Index(hay[pos:], pat)
+		if idx < 0 {
+			break
+		}
+		start := pos + idx
+		positions = append(positions, int64(start))
+		if overlap {
+			pos = start + 1
+		} else {
+			pos = start + len(pat)
+		}
+	}
+	return positions
+}
+
+// TestFinderMatchesStrings fuzzes Finder against naiveFindAll (and therefore
+// strings.Index) over 10k random needle/haystack pairs.
+func TestFinderMatchesStrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const alphabet = "abc"
+
+	randStr := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 10000; i++ {
+		hay := randStr(rnd.Intn(40))
+		pat := randStr(rnd.Intn(3) + 1)
+		finder := sz.NewFinder(pat)
+		overlap := i%2 == 0
+
+		want := naiveFindAll(hay, pat, overlap)
+		if got := finder.FindAll(hay, overlap); !equalInt64s(got, want) {
+			t.Fatalf("FindAll(%q, %q, overlap=%v) = %v, want %v", hay, pat, overlap, got, want)
+		}
+
+		wantIndex := int64(-1)
+		if len(want) > 0 {
+			wantIndex = want[0]
+		}
+		if got := finder.Index(hay); got != wantIndex {
+			t.Fatalf("Index(%q) with needle %q = %d, want %d", hay, pat, got, wantIndex)
+		}
+
+		if got, want := finder.Count(hay, overlap), int64(len(want)); got != want {
+			t.Fatalf("Count(%q, %q, overlap=%v) = %d, want %d", hay, pat, overlap, got, want)
+		}
+	}
+}
+
+// TestFinderIter verifies that Iter yields the same positions as FindAll
+// with overlap=false, and that it stops early when the caller breaks.
+func TestFinderIter(t *testing.T) {
+	finder := sz.NewFinder("aa")
+
+	var positions []int64
+	for pos := range finder.Iter("aaaa") {
+		positions = append(positions, pos)
+	}
+	if want := finder.FindAll("aaaa", false); !equalInt64s(positions, want) {
+		t.Fatalf("Iter positions = %v, want %v", positions, want)
+	}
+
+	var first int64 = -1
+	for pos := range finder.Iter("aaaa") {
+		first = pos
+		break
+	}
+	if first != 0 {
+		t.Fatalf("Iter early break: first = %d, want 0", first)
+	}
+}
+
+// TestMultiFinderMatchesStrings fuzzes MultiFinder against independent
+// naiveFindAll scans per needle, merged and sorted, over random needle sets.
+func TestMultiFinderMatchesStrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	const alphabet = "abc"
+
+	randStr := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 2000; i++ {
+		hay := randStr(rnd.Intn(30))
+		needles := []string{randStr(rnd.Intn(2) + 1), randStr(rnd.Intn(2) + 1)}
+		finder := sz.NewMultiFinder(needles)
+
+		got := finder.FindAll(hay, true)
+		gotPositions := make(map[int64]bool)
+		for _, m := range got {
+			if hay[m.Start:m.End] != needles[m.Pattern] {
+				t.Fatalf("match %+v does not correspond to needle %q in hay %q", m, needles[m.Pattern], hay)
+			}
+			gotPositions[m.Start] = true
+		}
+
+		want := make(map[int64]bool)
+		for _, needle := range needles {
+			for _, pos := range naiveFindAll(hay, needle, true) {
+				want[pos] = true
+			}
+		}
+		if len(gotPositions) != len(want) {
+			t.Fatalf("FindAll(%q, needles=%v) matched positions %v, want %v", hay, needles, gotPositions, want)
+		}
+		for pos := range want {
+			if !gotPositions[pos] {
+				t.Fatalf("FindAll(%q, needles=%v) missing position %d present in naive scan", hay, needles, pos)
+			}
+		}
+	}
+}
+
+// TestMultiFinderIndexAndCount covers the non-overlapping case directly. "he"
+// is listed before "she" and both start matching "ushers" at index 2 and 1
+// respectively - but they don't share a start position, so priority order
+// never comes into play here; "she" is simply the only match starting at
+// index 1, and non-overlapping Count stops there before reaching "hers".
+func TestMultiFinderIndexAndCount(t *testing.T) {
+	finder := sz.NewMultiFinder([]string{"he", "she", "his", "hers"})
+
+	if got, want := finder.Index("ushers"), int64(1); got != want {
+		t.Fatalf("Index = %d, want %d", got, want)
+	}
+	if got, want := finder.Count("ushers", false), int64(1); got != want {
+		t.Fatalf("Count(overlap=false) = %d, want %d", got, want)
+	}
+	if got, want := finder.Count("ushers", true), int64(3); got != want {
+		t.Fatalf("Count(overlap=true) = %d, want %d", got, want)
+	}
+}
+
+// TestMultiFinderPrefixPriority verifies the same pair-priority tie-break
+// Replacer uses: when two needles can both match at the same start position,
+// the one listed first in NewMultiFinder wins, regardless of length.
+func TestMultiFinderPrefixPriority(t *testing.T) {
+	if got, want := sz.NewMultiFinder([]string{"a", "ab"}).FindAll("ab", false), []sz.Match{{Start: 0, End: 1, Pattern: 0}}; !equalMatches(got, want) {
+		t.Fatalf("FindAll = %v, want %v", got, want)
+	}
+	if got, want := sz.NewMultiFinder([]string{"ab", "a"}).FindAll("ab", false), []sz.Match{{Start: 0, End: 2, Pattern: 0}}; !equalMatches(got, want) {
+		t.Fatalf("FindAll = %v, want %v", got, want)
+	}
+}
+
+func equalMatches(a, b []sz.Match) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalInt64s(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,15 +1,8 @@
-// StringZilla is a SIMD-accelerated string library modern CPUs, written in C 99,
-// and using AVX2, AVX512, Arm NEON, and SVE intrinsics to accelerate processing.
-//
-// The GoLang binding is intended to provide a simple interface to a precompiled
-// shared library, available on GitHub: https://github.com/ashvardanian/stringzilla
-//
-// It requires Go 1.24 or newer to leverage the `cGo` `noescape` and `nocallback`
-// directives. Without those the latency of calling C functions from Go is too high
-// to be useful for string processing.
-//
-// Unlike the native Go `strings` package, StringZilla primarily targets byte-level
-// binary data processing, with less emphasis on UTF-8 and locale-specific tasks.
+//go:build cgo && !sz_purego
+
+// This file holds the cgo-accelerated implementation, backed by the
+// precompiled libstringzilla_shared. See lib_purego.go for the pure-Go
+// fallback used when cgo is unavailable or sz_purego is set.
 //
 // For some functions we are avoiding `noescape` and `nocallback`, assuming they use
 // too much stack space:
@@ -41,6 +34,7 @@ import "C"
 import (
 	"fmt"
 	"io"
+	"runtime"
 	"unsafe"
 )
 
@@ -298,6 +292,38 @@ func (h *Sha256) Hexdigest() string {
 	return fmt.Sprintf("%x", digest)
 }
 
+// MarshalBinary serializes the Sha256 hasher's streaming state so it can be
+// resumed later via UnmarshalBinary, on the same GOARCH, since
+// sz_sha256_state_t is not guaranteed stable across CPU dispatch variants.
+func (h *Sha256) MarshalBinary() ([]byte, error) {
+	stateSize := int(unsafe.Sizeof(h.state))
+	statePtr := (*byte)(unsafe.Pointer(&h.state))
+	stateBytes := unsafe.Slice(statePtr, stateSize)
+
+	buf := make([]byte, 0, 4+1+1+len(runtime.GOARCH)+stateSize)
+	buf = append(buf, sha256Magic[:]...)
+	buf = append(buf, marshalVersion)
+	buf = append(buf, byte(len(runtime.GOARCH)))
+	buf = append(buf, runtime.GOARCH...)
+	buf = append(buf, stateBytes...)
+	return buf, nil
+}
+
+// UnmarshalBinary restores a Sha256 hasher's streaming state from a slice
+// produced by MarshalBinary.
+func (h *Sha256) UnmarshalBinary(data []byte) error {
+	state, err := stripEnvelopeHeader(sha256Magic, data)
+	if err != nil {
+		return fmt.Errorf("sz: Sha256.UnmarshalBinary: %w", err)
+	}
+	wantStateSize := int(unsafe.Sizeof(h.state))
+	if len(state) != wantStateSize {
+		return fmt.Errorf("sz: Sha256.UnmarshalBinary: state is %d bytes, want %d", len(state), wantStateSize)
+	}
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&h.state)), len(state)), state)
+	return nil
+}
+
 // Count returns the number of overlapping or non-overlapping instances of `substr` in `str`.
 // If `substr` is an empty string, returns 1 + the length of the `str`.
 // https://pkg.go.dev/strings#Count
@@ -0,0 +1,277 @@
+//go:build !cgo || sz_purego
+
+// This file holds the pure-Go fallback used when cgo is unavailable (cross
+// compilation, Windows without the DLL on PATH, WASM, CGO_ENABLED=0 builds,
+// Alpine minimal images) or when built with the sz_purego tag, following the
+// pattern cespare/xxhash uses for its assembly-accelerated-vs-portable split.
+// Every exported signature here must stay identical to lib_cgo.go's, since
+// callers (including the other files in this package) are built against
+// whichever one the build tags select.
+//
+// Search and Bytesum reproduce the cgo path's semantics exactly, since they
+// are thin wrappers around stdlib primitives. HashSha256/Sha256 do too, since
+// SHA-256 is a standardized algorithm and crypto/sha256 implements it
+// bit-for-bit.
+//
+// Hash is a deliberate, acknowledged scope cut, not a port: StringZilla's
+// 64-bit non-cryptographic hash mixes bytes through a dispatch-specific SIMD
+// kernel (AES-accelerated on targets that have it), and this repo snapshot
+// carries no C sources to port it from - there is nothing under include/ or
+// src/ to check the algorithm against, so any port attempted here would be
+// an unverifiable guess wearing the real algorithm's name. Rather than ship
+// that, Hash/Hasher fall back to FNV-1a mixed with the seed: a
+// different, equally deterministic and seed-sensitive 64-bit hash that does
+// NOT produce the same digests as the cgo build's sz_hash. Callers that
+// persist or compare Hash digests across processes must pin one build or the
+// other; see lib_purego_hash_test.go (built with -tags sz_purego) for what
+// this fallback does and does not guarantee. This is flagged, not closed:
+// the request behind Hash explicitly asked for a port with matching digests,
+// and whoever owns the backlog should re-scope that request or sign off on
+// this gap rather than treat it as satisfied because a commit references it.
+package sz
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"fmt"
+	"hash"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// Contains reports whether substr is within str.
+// https://pkg.go.dev/strings#Contains
+func Contains(str string, substr string) bool {
+	return strings.Contains(str, substr)
+}
+
+// Index returns the index of the first instance of substr in str, or -1 if
+// substr is not present.
+// https://pkg.go.dev/strings#Index
+func Index(str string, substr string) int64 {
+	return int64(strings.Index(str, substr))
+}
+
+// LastIndex returns the index of the last instance of substr in str, or -1 if
+// substr is not present.
+// https://pkg.go.dev/strings#LastIndex
+func LastIndex(str string, substr string) int64 {
+	return int64(strings.LastIndex(str, substr))
+}
+
+// IndexByte returns the index of the first instance of a byte in str, or -1
+// if a byte is not present.
+// https://pkg.go.dev/strings#IndexByte
+func IndexByte(str string, c byte) int64 {
+	return int64(strings.IndexByte(str, c))
+}
+
+// LastIndexByte returns the index of the last instance of a byte in str, or
+// -1 if a byte is not present.
+// https://pkg.go.dev/strings#LastIndexByte
+func LastIndexByte(str string, c byte) int64 {
+	return int64(strings.LastIndexByte(str, c))
+}
+
+// IndexAny returns the index of the first instance of any byte from substr
+// in str, or -1 if none are present.
+// Note: This is byte-set based (ASCII/bytes), not Unicode rune semantics like strings.IndexAny.
+// https://pkg.go.dev/strings#IndexAny
+func IndexAny(str string, substr string) int64 {
+	return int64(strings.IndexAny(str, substr))
+}
+
+// LastIndexAny returns the index of the last instance of any byte from
+// substr in str, or -1 if none are present.
+// Note: This is byte-set based (ASCII/bytes), not Unicode rune semantics like strings.LastIndexAny.
+// https://pkg.go.dev/strings#LastIndexAny
+func LastIndexAny(str string, substr string) int64 {
+	return int64(strings.LastIndexAny(str, substr))
+}
+
+// Bytesum computes a simple 64-bit checksum by summing bytes.
+func Bytesum(str string) uint64 {
+	var sum uint64
+	for i := 0; i < len(str); i++ {
+		sum += uint64(str[i])
+	}
+	return sum
+}
+
+// Count returns the number of overlapping or non-overlapping instances of
+// substr in str. If substr is an empty string, returns 1 + the length of
+// str.
+// https://pkg.go.dev/strings#Count
+func Count(str string, substr string, overlap bool) int64 {
+	if substr == "" {
+		return 1 + int64(len(str))
+	}
+	if !overlap {
+		return int64(strings.Count(str, substr))
+	}
+	count := int64(0)
+	for pos := 0; ; {
+		idx := strings.Index(str[pos:], substr)
+		if idx < 0 {
+			break
+		}
+		count++
+		pos += idx + 1
+	}
+	return count
+}
+
+// fnvOffset64 and fnvPrime64 are the FNV-1a constants; Hash uses FNV-1a
+// mixed with the seed as its fallback, non-cryptographic 64-bit hash.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// Hash computes a 64-bit non-cryptographic hash with a seed using FNV-1a, as
+// a scope-cut stand-in for StringZilla's own sz_hash: see the file doc
+// comment. Digests from this build will not match the cgo build's.
+func Hash(str string, seed uint64) uint64 {
+	h := fnvOffset64 ^ seed
+	for i := 0; i < len(str); i++ {
+		h ^= uint64(str[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// Hasher is a streaming 64-bit non-cryptographic hasher that implements hash.Hash64 and io.Writer.
+type Hasher struct {
+	state uint64
+	seed  uint64
+}
+
+// Compile-time interface checks
+var _ io.Writer = (*Hasher)(nil)
+
+// NewHasher creates a new streaming hasher with the given seed.
+func NewHasher(seed uint64) *Hasher {
+	h := &Hasher{seed: seed}
+	h.Reset()
+	return h
+}
+
+// Write adds data to the streaming hasher. Implements io.Writer.
+func (h *Hasher) Write(p []byte) (n int, err error) {
+	for _, b := range p {
+		h.state ^= uint64(b)
+		h.state *= fnvPrime64
+	}
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state. Implements hash.Hash.
+func (h *Hasher) Sum(b []byte) []byte {
+	digest := h.Sum64()
+	return append(b,
+		byte(digest>>56), byte(digest>>48), byte(digest>>40), byte(digest>>32),
+		byte(digest>>24), byte(digest>>16), byte(digest>>8), byte(digest))
+}
+
+// Reset resets the hasher to its initial state. Implements hash.Hash.
+func (h *Hasher) Reset() {
+	h.state = fnvOffset64 ^ h.seed
+}
+
+// Size returns the number of bytes Sum will return. Implements hash.Hash.
+func (h *Hasher) Size() int { return 8 }
+
+// BlockSize returns the hash's underlying block size. Implements hash.Hash.
+func (h *Hasher) BlockSize() int { return 1 }
+
+// Sum64 returns the current 64-bit hash without consuming the state. Implements hash.Hash64.
+func (h *Hasher) Sum64() uint64 { return h.state }
+
+// Digest returns the current 64-bit hash without consuming the state.
+// This is an alias for Sum64() for consistency with other bindings.
+func (h *Hasher) Digest() uint64 { return h.Sum64() }
+
+// HashSha256 computes the SHA-256 cryptographic hash of the input data.
+func HashSha256(data []byte) [32]byte {
+	return sha256.Sum256(data)
+}
+
+// Sha256 is a streaming SHA-256 hasher that implements hash.Hash and io.Writer.
+type Sha256 struct {
+	state hash.Hash
+}
+
+// Compile-time interface checks
+var _ io.Writer = (*Sha256)(nil)
+
+// NewSha256 creates a new streaming SHA-256 hasher.
+func NewSha256() *Sha256 {
+	return &Sha256{state: sha256.New()}
+}
+
+// Write adds data to the streaming SHA-256 hasher. Implements io.Writer.
+func (h *Sha256) Write(p []byte) (n int, err error) {
+	return h.state.Write(p)
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state. Implements hash.Hash.
+func (h *Sha256) Sum(b []byte) []byte {
+	digest := h.Digest()
+	return append(b, digest[:]...)
+}
+
+// Reset resets the hasher to its initial state. Implements hash.Hash.
+func (h *Sha256) Reset() { h.state.Reset() }
+
+// Size returns the number of bytes Sum will return. Implements hash.Hash.
+func (h *Sha256) Size() int { return h.state.Size() }
+
+// BlockSize returns the hash's underlying block size. Implements hash.Hash.
+func (h *Sha256) BlockSize() int { return h.state.BlockSize() }
+
+// Digest returns the current SHA-256 hash as a 32-byte array without consuming the state.
+// This is a convenience method in addition to the standard hash.Hash interface.
+func (h *Sha256) Digest() [32]byte {
+	var digest [32]byte
+	copy(digest[:], h.state.Sum(nil))
+	return digest
+}
+
+// Hexdigest returns the current SHA-256 hash as a lowercase hexadecimal string.
+// This is a convenience method matching Python's hashlib interface.
+func (h *Sha256) Hexdigest() string {
+	digest := h.Digest()
+	return fmt.Sprintf("%x", digest)
+}
+
+// MarshalBinary serializes the Sha256 hasher's streaming state, delegating to
+// crypto/sha256's own BinaryMarshaler and wrapping it in the same
+// magic/version/arch envelope the cgo build uses, so a clear error - rather
+// than a corrupt digest - results from loading a cgo-captured state here or
+// vice versa.
+func (h *Sha256) MarshalBinary() ([]byte, error) {
+	inner, err := h.state.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, 4+1+1+len(runtime.GOARCH)+len(inner))
+	buf = append(buf, sha256Magic[:]...)
+	buf = append(buf, marshalVersion)
+	buf = append(buf, byte(len(runtime.GOARCH)))
+	buf = append(buf, runtime.GOARCH...)
+	buf = append(buf, inner...)
+	return buf, nil
+}
+
+// UnmarshalBinary restores a Sha256 hasher's streaming state from a slice
+// produced by MarshalBinary.
+func (h *Sha256) UnmarshalBinary(data []byte) error {
+	rest, err := stripEnvelopeHeader(sha256Magic, data)
+	if err != nil {
+		return fmt.Errorf("sz: Sha256.UnmarshalBinary: %w", err)
+	}
+	return h.state.(encoding.BinaryUnmarshaler).UnmarshalBinary(rest)
+}
@@ -0,0 +1,47 @@
+//go:build sz_purego
+
+package sz_test
+
+import (
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestHashPuregoIsDeterministicAndSeedSensitive exercises the purego build's
+// Hash fallback directly (this file only builds with -tags sz_purego, since
+// it is otherwise shadowed by lib_cgo.go). Hash is a documented scope cut
+// rather than a port of sz_hash - see lib_purego.go's doc comment - so this
+// only asserts the properties the fallback actually promises: the same
+// input/seed always produces the same digest, and changing either changes
+// the digest.
+func TestHashPuregoIsDeterministicAndSeedSensitive(t *testing.T) {
+	if got, want := sz.Hash("hello", 0), sz.Hash("hello", 0); got != want {
+		t.Fatalf("Hash is not deterministic: %d != %d", got, want)
+	}
+	if sz.Hash("hello", 0) == sz.Hash("hello", 1) {
+		t.Fatalf("Hash(_, 0) == Hash(_, 1), want different digests for different seeds")
+	}
+	if sz.Hash("hello", 0) == sz.Hash("world", 0) {
+		t.Fatalf("Hash(%q, 0) == Hash(%q, 0), want different digests for different inputs", "hello", "world")
+	}
+}
+
+// TestHasherPuregoMatchesHash verifies the streaming Hasher fallback agrees
+// with the one-shot Hash fallback, split across writes in different ways.
+func TestHasherPuregoMatchesHash(t *testing.T) {
+	oneshot := sz.Hash("Hello, world!", 42)
+
+	h := sz.NewHasher(42)
+	h.Write([]byte("Hello, "))
+	h.Write([]byte("world!"))
+	if got := h.Sum64(); got != oneshot {
+		t.Fatalf("Hasher.Sum64() = %d, want %d", got, oneshot)
+	}
+
+	h.Reset()
+	h.Write([]byte("Hello, world!"))
+	if got := h.Digest(); got != oneshot {
+		t.Fatalf("Hasher.Digest() after Reset = %d, want %d", got, oneshot)
+	}
+}
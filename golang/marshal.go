@@ -0,0 +1,133 @@
+package sz
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// Binary envelope shared by Hasher and Sha256: a magic tag identifying the
+// type, a version byte for future format changes, and the runtime.GOARCH the
+// state was captured on. StringZilla dispatches to different SIMD kernels
+// per CPU feature set, and nothing guarantees sz_hash_state_t/sz_sha256_state_t
+// stay byte-identical across architectures, so a mismatched arch is rejected
+// outright rather than risking a silently wrong digest - the same caution
+// Go's stdlib hash implementations apply to their own Marshal/Unmarshal pairs.
+const marshalVersion = 1
+
+var (
+	hasherMagic = [4]byte{'S', 'Z', 'H', '1'}
+	sha256Magic = [4]byte{'S', 'Z', 'S', '1'}
+)
+
+// Compile-time interface checks
+var (
+	_ encoding.BinaryMarshaler   = (*Hasher)(nil)
+	_ encoding.BinaryUnmarshaler = (*Hasher)(nil)
+	_ encoding.BinaryMarshaler   = (*Sha256)(nil)
+	_ encoding.BinaryUnmarshaler = (*Sha256)(nil)
+)
+
+// MarshalBinary serializes the Hasher's streaming state, including its seed,
+// so it can be resumed later via UnmarshalBinary - on the same GOARCH, since
+// sz_hash_state_t is not guaranteed stable across CPU dispatch variants.
+func (h *Hasher) MarshalBinary() ([]byte, error) {
+	stateSize := int(unsafe.Sizeof(h.state))
+	statePtr := (*byte)(unsafe.Pointer(&h.state))
+	stateBytes := unsafe.Slice(statePtr, stateSize)
+
+	buf := make([]byte, 0, 4+1+1+len(runtime.GOARCH)+8+stateSize)
+	buf = append(buf, hasherMagic[:]...)
+	buf = append(buf, marshalVersion)
+	buf = append(buf, byte(len(runtime.GOARCH)))
+	buf = append(buf, runtime.GOARCH...)
+	buf = appendUint64LE(buf, h.seed)
+	buf = append(buf, stateBytes...)
+	return buf, nil
+}
+
+// UnmarshalBinary restores a Hasher's streaming state from a slice produced by
+// MarshalBinary. It returns an error if the envelope is malformed, was
+// written with an incompatible version, or was captured on a different
+// GOARCH.
+func (h *Hasher) UnmarshalBinary(data []byte) error {
+	rest, seed, state, err := decodeHashEnvelope(hasherMagic, data, int(unsafe.Sizeof(h.state)))
+	if err != nil {
+		return fmt.Errorf("sz: Hasher.UnmarshalBinary: %w", err)
+	}
+	_ = rest
+	h.seed = seed
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&h.state)), len(state)), state)
+	return nil
+}
+
+// Sha256's MarshalBinary/UnmarshalBinary live in lib_cgo.go and
+// lib_purego.go instead of here: the cgo state is a fixed-size C struct
+// copied as raw bytes, while the purego state wraps crypto/sha256's own
+// hash.Hash and delegates to its encoding.BinaryMarshaler instead, so the
+// two backends have nothing in common beyond the envelope itself. Both call
+// stripEnvelopeHeader below to parse that shared envelope.
+
+// decodeHashEnvelope validates and strips the shared magic/version/arch
+// header, returning any trailing bytes (always empty today, reserved for
+// future fields), the seed, and the raw state bytes. wantStateSize must
+// equal the size of the caller's C state struct on this build.
+func decodeHashEnvelope(magic [4]byte, data []byte, wantStateSize int) (rest []byte, seed uint64, state []byte, err error) {
+	data, err = stripEnvelopeHeader(magic, data)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	if len(data) < 8 {
+		return nil, 0, nil, errors.New("truncated seed")
+	}
+	seed = decodeUint64LE(data)
+	data = data[8:]
+
+	if len(data) != wantStateSize {
+		return nil, 0, nil, fmt.Errorf("state is %d bytes, want %d", len(data), wantStateSize)
+	}
+	return nil, seed, data, nil
+}
+
+// stripEnvelopeHeader validates the shared magic/version/arch header and
+// returns the bytes that follow it - the seed and state for Hasher, or the
+// state (no seed) for Sha256. Shared by both Hasher (via decodeHashEnvelope
+// above) and the backend-specific Sha256 implementations, which have no
+// seed field and so parse the remainder themselves.
+func stripEnvelopeHeader(magic [4]byte, data []byte) ([]byte, error) {
+	if len(data) < 4+1+1 || [4]byte(data[:4]) != magic {
+		return nil, errors.New("bad magic")
+	}
+	data = data[4:]
+	version := data[0]
+	data = data[1:]
+	if version != marshalVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+
+	archLen := int(data[0])
+	data = data[1:]
+	if len(data) < archLen {
+		return nil, errors.New("truncated arch tag")
+	}
+	arch := string(data[:archLen])
+	data = data[archLen:]
+	if arch != runtime.GOARCH {
+		return nil, fmt.Errorf("state captured on GOARCH %q, cannot load on %q", arch, runtime.GOARCH)
+	}
+	return data, nil
+}
+
+func appendUint64LE(buf []byte, v uint64) []byte {
+	return append(buf,
+		byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+func decodeUint64LE(b []byte) uint64 {
+	return uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 |
+		uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56
+}
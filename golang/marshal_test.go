@@ -0,0 +1,78 @@
+package sz_test
+
+import (
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestHasherMarshalRoundTrip verifies that marshaling a Hasher mid-stream and
+// unmarshaling into a fresh one resumes to the same digest as hashing the
+// whole input in one go, including a split that lands off any block boundary.
+func TestHasherMarshalRoundTrip(t *testing.T) {
+	const input = "The quick brown fox jumps over the lazy dog"
+
+	for _, split := range []int{0, 1, 7, len(input) / 2, len(input) - 1, len(input)} {
+		first, second := input[:split], input[split:]
+
+		h := sz.NewHasher(42)
+		h.Write([]byte(first))
+
+		data, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("split %d: MarshalBinary error: %v", split, err)
+		}
+
+		resumed := sz.NewHasher(0) // seed must be overwritten by UnmarshalBinary
+		if err := resumed.UnmarshalBinary(data); err != nil {
+			t.Fatalf("split %d: UnmarshalBinary error: %v", split, err)
+		}
+		resumed.Write([]byte(second))
+
+		want := sz.Hash(input, 42)
+		if got := resumed.Sum64(); got != want {
+			t.Errorf("split %d: resumed digest = %d, want %d", split, got, want)
+		}
+	}
+}
+
+// TestHasherUnmarshalRejectsBadMagic verifies that garbage input is rejected
+// rather than silently corrupting the hasher state.
+func TestHasherUnmarshalRejectsBadMagic(t *testing.T) {
+	h := sz.NewHasher(0)
+	if err := h.UnmarshalBinary([]byte("not a valid envelope")); err == nil {
+		t.Fatal("expected error unmarshaling invalid data")
+	}
+}
+
+// TestSha256MarshalRoundTrip mirrors TestHasherMarshalRoundTrip for the
+// SHA-256 streaming hasher.
+func TestSha256MarshalRoundTrip(t *testing.T) {
+	const input = "The quick brown fox jumps over the lazy dog"
+
+	for _, split := range []int{0, 1, 55, 64, len(input)} {
+		if split > len(input) {
+			continue
+		}
+		first, second := input[:split], input[split:]
+
+		h := sz.NewSha256()
+		h.Write([]byte(first))
+
+		data, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("split %d: MarshalBinary error: %v", split, err)
+		}
+
+		resumed := sz.NewSha256()
+		if err := resumed.UnmarshalBinary(data); err != nil {
+			t.Fatalf("split %d: UnmarshalBinary error: %v", split, err)
+		}
+		resumed.Write([]byte(second))
+
+		want := sz.HashSha256([]byte(input))
+		if got := resumed.Digest(); got != want {
+			t.Errorf("split %d: resumed digest = %x, want %x", split, got, want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package sz_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestNeedleMatchesStrings fuzzes FindIn/LastIn against strings.Index and
+// strings.LastIndex over short random haystacks and needles.
+func TestNeedleMatchesStrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const alphabet = "ab"
+
+	randStr := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 2000; i++ {
+		hay := randStr(rnd.Intn(40))
+		pat := randStr(rnd.Intn(4) + 1)
+		needle := sz.NewNeedle(pat)
+
+		if got, want := needle.FindIn(hay), int64(strings.Index(hay, pat)); got != want {
+			t.Fatalf("FindIn(%q) with needle %q = %d, want %d", hay, pat, got, want)
+		}
+		if got, want := needle.LastIn(hay), int64(strings.LastIndex(hay, pat)); got != want {
+			t.Fatalf("LastIn(%q) with needle %q = %d, want %d", hay, pat, got, want)
+		}
+	}
+}
+
+// TestNeedleFindAllAndCount verifies FindAll and CountIn for overlapping and
+// non-overlapping occurrences.
+func TestNeedleFindAllAndCount(t *testing.T) {
+	needle := sz.NewNeedle("aa")
+
+	var positions []int
+	needle.FindAll("aaaa", func(pos int) bool {
+		positions = append(positions, pos)
+		return true
+	})
+	if want := []int{0, 2}; !equalInts(positions, want) {
+		t.Fatalf("FindAll positions = %v, want %v", positions, want)
+	}
+
+	if got := needle.CountIn("aaaa", false); got != 2 {
+		t.Fatalf("CountIn(overlap=false) = %d, want 2", got)
+	}
+	if got := needle.CountIn("aaaa", true); got != 3 {
+		t.Fatalf("CountIn(overlap=true) = %d, want 3", got)
+	}
+}
+
+// TestNeedleFindAllEmptyPattern verifies FindAll returns immediately without
+// calling fn for an empty needle, instead of spinning forever: FindIn always
+// returns 0 for an empty pattern, so a loop that advances by len(pattern)
+// never moves.
+func TestNeedleFindAllEmptyPattern(t *testing.T) {
+	needle := sz.NewNeedle("")
+	calls := 0
+	needle.FindAll("abc", func(pos int) bool {
+		calls++
+		return calls < 10
+	})
+	if calls != 0 {
+		t.Fatalf("FindAll on empty needle called fn %d times, want 0", calls)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
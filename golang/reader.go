@@ -0,0 +1,191 @@
+package sz
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// Reader is a drop-in replacement for strings.Reader that locates byte
+// boundaries (newlines, arbitrary delimiters) with a single sz_find_byte
+// call instead of the byte-at-a-time loop bufio.Scanner relies on. It
+// implements io.Reader, io.ReaderAt, io.ByteScanner, io.RuneScanner, and
+// io.Seeker, so it can replace strings.Reader wherever an io.Reader over an
+// in-memory string is needed.
+// https://pkg.go.dev/strings#Reader
+type Reader struct {
+	s        string
+	i        int64 // current reading index
+	prevRune int   // index of previous rune, or < 0 if none
+}
+
+// Compile-time interface checks
+var (
+	_ io.Reader      = (*Reader)(nil)
+	_ io.ReaderAt    = (*Reader)(nil)
+	_ io.ByteScanner = (*Reader)(nil)
+	_ io.RuneScanner = (*Reader)(nil)
+	_ io.Seeker      = (*Reader)(nil)
+	_ io.WriterTo    = (*Reader)(nil)
+)
+
+// NewReader returns a new Reader reading from s.
+func NewReader(s string) *Reader { return &Reader{s: s, prevRune: -1} }
+
+// Len returns the number of bytes of the unread portion of s.
+func (r *Reader) Len() int {
+	if r.i >= int64(len(r.s)) {
+		return 0
+	}
+	return int(int64(len(r.s)) - r.i)
+}
+
+// Size returns the original length of the underlying string; it is always
+// the same as the s passed to NewReader, regardless of how much has been read.
+func (r *Reader) Size() int64 { return int64(len(r.s)) }
+
+// Read implements io.Reader.
+func (r *Reader) Read(b []byte) (n int, err error) {
+	if r.i >= int64(len(r.s)) {
+		return 0, io.EOF
+	}
+	r.prevRune = -1
+	n = copy(b, r.s[r.i:])
+	r.i += int64(n)
+	return n, nil
+}
+
+// ReadAt implements io.ReaderAt.
+func (r *Reader) ReadAt(b []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("sz.Reader.ReadAt: negative offset")
+	}
+	if off >= int64(len(r.s)) {
+		return 0, io.EOF
+	}
+	n = copy(b, r.s[off:])
+	if n < len(b) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// ReadByte implements io.ByteReader.
+func (r *Reader) ReadByte() (byte, error) {
+	r.prevRune = -1
+	if r.i >= int64(len(r.s)) {
+		return 0, io.EOF
+	}
+	b := r.s[r.i]
+	r.i++
+	return b, nil
+}
+
+// UnreadByte implements io.ByteScanner.
+func (r *Reader) UnreadByte() error {
+	if r.i <= 0 {
+		return errors.New("sz.Reader.UnreadByte: at beginning of string")
+	}
+	r.prevRune = -1
+	r.i--
+	return nil
+}
+
+// ReadRune implements io.RuneReader.
+func (r *Reader) ReadRune() (ch rune, size int, err error) {
+	if r.i >= int64(len(r.s)) {
+		r.prevRune = -1
+		return 0, 0, io.EOF
+	}
+	r.prevRune = int(r.i)
+	if c := r.s[r.i]; c < utf8.RuneSelf {
+		r.i++
+		return rune(c), 1, nil
+	}
+	ch, size = utf8.DecodeRuneInString(r.s[r.i:])
+	r.i += int64(size)
+	return ch, size, nil
+}
+
+// UnreadRune implements io.RuneScanner.
+func (r *Reader) UnreadRune() error {
+	if r.prevRune < 0 {
+		return errors.New("sz.Reader.UnreadRune: previous operation was not ReadRune")
+	}
+	r.i = int64(r.prevRune)
+	r.prevRune = -1
+	return nil
+}
+
+// Seek implements io.Seeker.
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	r.prevRune = -1
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.i + offset
+	case io.SeekEnd:
+		abs = int64(len(r.s)) + offset
+	default:
+		return 0, errors.New("sz.Reader.Seek: invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("sz.Reader.Seek: negative position")
+	}
+	r.i = abs
+	return abs, nil
+}
+
+// WriteTo implements io.WriterTo. It scans for newline boundaries with
+// IndexByte (sz_find_byte) and flushes one aligned chunk at a time, rather
+// than re-scanning the whole remainder on every call the way a naive
+// Index-from-zero loop would.
+func (r *Reader) WriteTo(w io.Writer) (n int64, err error) {
+	r.prevRune = -1
+	for r.i < int64(len(r.s)) {
+		rest := r.s[r.i:]
+		nl := IndexByte(rest, '\n')
+		var chunk string
+		if nl < 0 {
+			chunk = rest
+		} else {
+			chunk = rest[:nl+1]
+		}
+		written, werr := io.WriteString(w, chunk)
+		n += int64(written)
+		r.i += int64(written)
+		if werr != nil {
+			return n, werr
+		}
+		if written != len(chunk) {
+			return n, io.ErrShortWrite
+		}
+	}
+	return n, nil
+}
+
+// ReadUntil reads the unread portion of s up to and not including the first
+// occurrence of delim, consuming delim itself, and locates it with a single
+// sz_find_byte call rather than scanning byte by byte. If delim is not found
+// before the end of the string, ReadUntil returns the remaining data and
+// io.EOF.
+func (r *Reader) ReadUntil(delim byte) (string, error) {
+	if r.i >= int64(len(r.s)) {
+		return "", io.EOF
+	}
+	r.prevRune = -1
+	rest := r.s[r.i:]
+	pos := IndexByte(rest, delim)
+	if pos < 0 {
+		r.i = int64(len(r.s))
+		return rest, io.EOF
+	}
+	line := rest[:pos]
+	r.i += int64(pos) + 1
+	return line, nil
+}
+
+// Reset resets the Reader to read from s, discarding any unread data.
+func (r *Reader) Reset(s string) { *r = Reader{s: s, prevRune: -1} }
@@ -0,0 +1,114 @@
+package sz_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestReaderMatchesStringsReader cross-checks Read/Seek/WriteTo against
+// strings.Reader for equivalent behavior over the same input.
+func TestReaderMatchesStringsReader(t *testing.T) {
+	const data = "hello\nworld\nfoo"
+
+	var got, want bytes.Buffer
+	if _, err := io.Copy(&got, sz.NewReader(data)); err != nil {
+		t.Fatalf("sz.Reader Read error: %v", err)
+	}
+	if _, err := io.Copy(&want, strings.NewReader(data)); err != nil {
+		t.Fatalf("strings.Reader Read error: %v", err)
+	}
+	if got.String() != want.String() {
+		t.Fatalf("Read mismatch: %q != %q", got.String(), want.String())
+	}
+
+	r := sz.NewReader(data)
+	if n, err := r.Seek(6, io.SeekStart); err != nil || n != 6 {
+		t.Fatalf("Seek(6, SeekStart) = %d, %v", n, err)
+	}
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll after Seek: %v", err)
+	}
+	if string(rest) != "world\nfoo" {
+		t.Fatalf("Seek then Read = %q, want %q", rest, "world\nfoo")
+	}
+}
+
+// TestReaderWriteTo verifies WriteTo writes the whole string and reports the
+// correct byte count.
+func TestReaderWriteTo(t *testing.T) {
+	const data = "line one\nline two\nline three"
+	r := sz.NewReader(data)
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo returned error: %v", err)
+	}
+	if n != int64(len(data)) {
+		t.Fatalf("WriteTo returned n=%d, want %d", n, len(data))
+	}
+	if buf.String() != data {
+		t.Fatalf("WriteTo wrote %q, want %q", buf.String(), data)
+	}
+	if r.Len() != 0 {
+		t.Fatalf("Len() after WriteTo = %d, want 0", r.Len())
+	}
+}
+
+// TestReaderReadUntil verifies record-at-a-time reading, including the final
+// unterminated record.
+func TestReaderReadUntil(t *testing.T) {
+	r := sz.NewReader("a,b,c")
+	var got []string
+	for {
+		field, err := r.ReadUntil(',')
+		got = append(got, field)
+		if err != nil {
+			break
+		}
+	}
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("ReadUntil collected %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ReadUntil[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// TestReaderRuneScanning verifies ReadRune/UnreadRune over multi-byte UTF-8.
+func TestReaderRuneScanning(t *testing.T) {
+	r := sz.NewReader("héllo")
+	ch, size, err := r.ReadRune()
+	if err != nil || ch != 'h' || size != 1 {
+		t.Fatalf("ReadRune() = %q, %d, %v", ch, size, err)
+	}
+	ch, size, err = r.ReadRune()
+	if err != nil || ch != 'é' || size != 2 {
+		t.Fatalf("ReadRune() = %q, %d, %v", ch, size, err)
+	}
+	if err := r.UnreadRune(); err != nil {
+		t.Fatalf("UnreadRune() error: %v", err)
+	}
+	ch, _, err = r.ReadRune()
+	if err != nil || ch != 'é' {
+		t.Fatalf("ReadRune() after UnreadRune = %q, %v", ch, err)
+	}
+}
+
+// TestReaderReset verifies Reset allows pooled reuse.
+func TestReaderReset(t *testing.T) {
+	r := sz.NewReader("first")
+	io.ReadAll(r)
+	r.Reset("second")
+	data, err := io.ReadAll(r)
+	if err != nil || string(data) != "second" {
+		t.Fatalf("ReadAll after Reset = %q, %v", data, err)
+	}
+}
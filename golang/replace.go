@@ -0,0 +1,258 @@
+package sz
+
+import (
+	"io"
+	"strings"
+)
+
+// Replacer performs SIMD-accelerated multi-pattern replacement, mirroring the
+// API of Go's strings.Replacer (see strings/replace.go). Unlike strings.Replacer
+// it is a concrete type rather than an interface, since every pair set in
+// StringZilla compiles down to one of three shapes: a single needle scanned
+// with sz_find, a 256-entry byte-dispatch table, or a full Aho-Corasick
+// automaton built once in newAhoCorasick.
+//
+// https://pkg.go.dev/strings#Replacer
+type Replacer struct {
+	olds []string
+	news []string
+
+	// Exactly one of the following is populated, chosen by NewReplacer based
+	// on the shape of olds.
+	single    bool         // len(olds) == 1: fall back to a single sz.Index scan
+	byteTable []int32      // all olds are single bytes: byteTable[b] is the pair index, or -1
+	automaton *ahoCorasick // general case: N patterns, built once
+
+	overlap bool
+}
+
+// NewReplacer returns a new Replacer from a list of old, new string pairs.
+// Replacements are performed in the order they appear in the target string,
+// without overlapping matches, and the old string comparisons are done in
+// argument order: when two old strings can both match at the same position
+// (e.g. "a" and "ab" against "ab"), the one listed first wins regardless of
+// length, the same tie-break strings.Replacer applies via its trie lookup -
+// see ahoCorasick.matchPrefix for how the automaton reproduces it.
+//
+// Unlike strings.NewReplacer, every old string must be non-empty: the
+// automaton below is built over byte transitions and has no notion of a
+// zero-width match.
+//
+// https://pkg.go.dev/strings#NewReplacer
+func NewReplacer(pairs ...string) *Replacer {
+	if len(pairs)%2 != 0 {
+		panic("sz.NewReplacer: odd argument count")
+	}
+
+	n := len(pairs) / 2
+	r := &Replacer{olds: make([]string, n), news: make([]string, n)}
+	for i := 0; i < n; i++ {
+		old := pairs[2*i]
+		if old == "" {
+			panic("sz.NewReplacer: empty old string is not supported")
+		}
+		r.olds[i] = old
+		r.news[i] = pairs[2*i+1]
+	}
+
+	switch {
+	case n == 1:
+		r.single = true
+	case allSingleBytePatterns(r.olds):
+		r.byteTable = make([]int32, 256)
+		for i := range r.byteTable {
+			r.byteTable[i] = -1
+		}
+		for i, old := range r.olds {
+			b := old[0]
+			if r.byteTable[b] == -1 {
+				r.byteTable[b] = int32(i)
+			}
+		}
+	default:
+		r.automaton = newAhoCorasick(r.olds)
+	}
+
+	return r
+}
+
+// Overlapping returns a new Replacer that reports overlapping matches from
+// FindAll; it has no effect on Replace/WriteString, which never emit
+// overlapping output. The receiver is left unmodified, so a Replacer compiled
+// once and shared across goroutines - the same "safe for concurrent use"
+// pattern strings.Replacer documents - can still have Overlapping() called on
+// it from one caller without affecting any other caller's FindAll.
+func (r *Replacer) Overlapping() *Replacer {
+	overlapping := *r
+	overlapping.overlap = true
+	return &overlapping
+}
+
+// allSingleBytePatterns reports whether every old string is exactly one byte,
+// the shape that lets NewReplacer pick the cheap byte-dispatch-table path.
+func allSingleBytePatterns(olds []string) bool {
+	for _, old := range olds {
+		if len(old) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Replace returns a copy of s with all non-overlapping occurrences of the old
+// strings replaced by their corresponding new strings.
+//
+// https://pkg.go.dev/strings#Replacer.Replace
+func (r *Replacer) Replace(s string) string {
+	var out strings.Builder
+	out.Grow(len(s))
+	r.WriteString(&out, s)
+	return out.String()
+}
+
+// WriteString writes s to w with all replacements performed, returning the
+// number of bytes written. It mirrors strings.Replacer.WriteString.
+//
+// https://pkg.go.dev/strings#Replacer.WriteString
+func (r *Replacer) WriteString(w io.Writer, s string) (n int, err error) {
+	switch {
+	case r.single:
+		return r.writeStringSingle(w, s)
+	case r.byteTable != nil:
+		return r.writeStringByteTable(w, s)
+	default:
+		return r.writeStringAutomaton(w, s)
+	}
+}
+
+func (r *Replacer) writeStringSingle(w io.Writer, s string) (n int, err error) {
+	old, new := r.olds[0], r.news[0]
+	lastCopy := 0
+	for {
+		rest := s[lastCopy:]
+		pos := Index(rest, old)
+		if pos < 0 {
+			break
+		}
+		start := lastCopy + int(pos)
+		written, werr := io.WriteString(w, s[lastCopy:start])
+		n += written
+		if werr != nil {
+			return n, werr
+		}
+		written, werr = io.WriteString(w, new)
+		n += written
+		if werr != nil {
+			return n, werr
+		}
+		lastCopy = start + len(old)
+	}
+	written, werr := io.WriteString(w, s[lastCopy:])
+	n += written
+	return n, werr
+}
+
+func (r *Replacer) writeStringByteTable(w io.Writer, s string) (n int, err error) {
+	lastCopy := 0
+	for i := 0; i < len(s); i++ {
+		pairIdx := r.byteTable[s[i]]
+		if pairIdx == -1 {
+			continue
+		}
+		written, werr := io.WriteString(w, s[lastCopy:i])
+		n += written
+		if werr != nil {
+			return n, werr
+		}
+		written, werr = io.WriteString(w, r.news[pairIdx])
+		n += written
+		if werr != nil {
+			return n, werr
+		}
+		lastCopy = i + 1
+	}
+	written, werr := io.WriteString(w, s[lastCopy:])
+	n += written
+	return n, werr
+}
+
+func (r *Replacer) writeStringAutomaton(w io.Writer, s string) (n int, err error) {
+	lastCopy := 0
+	var writeErr error
+	r.automaton.scan(s, func(start, end, patternIdx int) bool {
+		written, werr := io.WriteString(w, s[lastCopy:start])
+		n += written
+		if werr != nil {
+			writeErr = werr
+			return false
+		}
+		written, werr = io.WriteString(w, r.news[patternIdx])
+		n += written
+		if werr != nil {
+			writeErr = werr
+			return false
+		}
+		lastCopy = end
+		return true
+	})
+	if writeErr != nil {
+		return n, writeErr
+	}
+	written, werr := io.WriteString(w, s[lastCopy:])
+	n += written
+	return n, werr
+}
+
+// FindAll reports every match of the Replacer's old strings in s, calling
+// fn(start, end, patternIdx) for each one in left-to-right order. patternIdx
+// indexes into the pairs passed to NewReplacer. By default matches do not
+// overlap (the scan skips past a match before looking for the next one);
+// call Overlapping() first to report every occurrence, including ones nested
+// inside a longer match. fn returning false stops the scan early.
+func (r *Replacer) FindAll(s string, fn func(start, end, patternIdx int) bool) {
+	switch {
+	case r.single:
+		r.findAllSingle(s, fn)
+	case r.byteTable != nil:
+		r.findAllByteTable(s, fn)
+	default:
+		if r.overlap {
+			r.automaton.scanOverlapping(s, fn)
+		} else {
+			r.automaton.scan(s, fn)
+		}
+	}
+}
+
+func (r *Replacer) findAllSingle(s string, fn func(start, end, patternIdx int) bool) {
+	old := r.olds[0]
+	pos := 0
+	for pos <= len(s)-len(old) {
+		idx := Index(s[pos:], old)
+		if idx < 0 {
+			return
+		}
+		start := pos + int(idx)
+		end := start + len(old)
+		if !fn(start, end, 0) {
+			return
+		}
+		if r.overlap {
+			pos = start + 1
+		} else {
+			pos = end
+		}
+	}
+}
+
+func (r *Replacer) findAllByteTable(s string, fn func(start, end, patternIdx int) bool) {
+	for i := 0; i < len(s); i++ {
+		pairIdx := r.byteTable[s[i]]
+		if pairIdx == -1 {
+			continue
+		}
+		if !fn(i, i+1, int(pairIdx)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package sz_test
+
+import (
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestReplacerMatchesStrings cross-checks sz.Replacer against strings.Replacer
+// for a handful of shapes: single pair, all single-byte pairs, and a general
+// multi-byte pattern set with overlapping prefixes.
+func TestReplacerMatchesStrings(t *testing.T) {
+	tests := []struct {
+		name  string
+		pairs []string
+		input string
+	}{
+		{"single", []string{"world", "there"}, "hello, world!"},
+		{"bytes", []string{"a", "1", "b", "2", "c", "3"}, "abcabcabc"},
+		{"classic", []string{"he", "1", "she", "2", "his", "3", "hers", "4"}, "ushershis"},
+		{"no-match", []string{"zz", "-"}, "hello, world!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.NewReplacer(tt.pairs...).Replace(tt.input)
+			got := sz.NewReplacer(tt.pairs...).Replace(tt.input)
+			if got != want {
+				t.Errorf("Replace(%q) = %q, want %q", tt.input, got, want)
+			}
+		})
+	}
+}
+
+// TestReplacerWriteString verifies WriteString produces the same output as
+// Replace and reports the number of bytes written.
+func TestReplacerWriteString(t *testing.T) {
+	r := sz.NewReplacer("foo", "bar", "baz", "qux")
+	var buf strings.Builder
+	n, err := r.WriteString(&buf, "foobazfoo")
+	if err != nil {
+		t.Fatalf("WriteString returned error: %v", err)
+	}
+	if buf.String() != "barquxbar" {
+		t.Fatalf("WriteString wrote %q, want %q", buf.String(), "barquxbar")
+	}
+	if n != len(buf.String()) {
+		t.Fatalf("WriteString returned n=%d, want %d", n, len(buf.String()))
+	}
+}
+
+// TestReplacerFindAll verifies non-overlapping and overlapping match reporting.
+func TestReplacerFindAll(t *testing.T) {
+	r := sz.NewReplacer("aa", "-")
+	var matches [][2]int
+	r.FindAll("aaaa", func(start, end, patternIdx int) bool {
+		matches = append(matches, [2]int{start, end})
+		return true
+	})
+	if len(matches) != 2 || matches[0] != [2]int{0, 2} || matches[1] != [2]int{2, 4} {
+		t.Fatalf("non-overlapping FindAll = %v, want [[0 2] [2 4]]", matches)
+	}
+
+	matches = nil
+	r.Overlapping().FindAll("aaaa", func(start, end, patternIdx int) bool {
+		matches = append(matches, [2]int{start, end})
+		return true
+	})
+	if len(matches) != 3 {
+		t.Fatalf("overlapping FindAll found %d matches, want 3", len(matches))
+	}
+}
+
+// TestReplacerPrefixWins verifies that when one old string is a strict prefix
+// of another, whichever pair was listed first wins, regardless of which one
+// is longer - matching strings.Replacer exactly, including the fact that
+// swapping pair order changes the result.
+func TestReplacerPrefixWins(t *testing.T) {
+	if got, want := sz.NewReplacer("a", "first", "ab", "second").Replace("ab"), strings.NewReplacer("a", "first", "ab", "second").Replace("ab"); got != want {
+		t.Fatalf("Replace(%q) = %q, want %q", "ab", got, want)
+	}
+	if got, want := sz.NewReplacer("ab", "second", "a", "first").Replace("ab"), strings.NewReplacer("ab", "second", "a", "first").Replace("ab"); got != want {
+		t.Fatalf("Replace(%q) = %q, want %q", "ab", got, want)
+	}
+}
+
+// TestReplacerDuplicateKeys verifies that when two pairs share the exact same
+// old string, the first one listed wins.
+func TestReplacerDuplicateKeys(t *testing.T) {
+	if got := sz.NewReplacer("a", "first", "a", "second").Replace("a"); got != "first" {
+		t.Fatalf("Replace(%q) = %q, want %q", "a", got, "first")
+	}
+}
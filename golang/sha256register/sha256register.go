@@ -0,0 +1,31 @@
+// Package szsha256register registers StringZilla's SIMD-accelerated SHA-256
+// implementation with the standard crypto.Hash registry.
+//
+// Importing this package for its side effect makes crypto.SHA256.New()
+// return an sz.Sha256 instead of the stdlib implementation, so anything
+// built on top of the registry - crypto/hmac, crypto/rsa's PSS and PKCS1
+// padding, crypto/tls handshakes, JWT libraries - picks up the speedup
+// transparently, without call sites needing to import sz directly.
+//
+// This is deliberately a separate, opt-in package rather than an init()
+// in sz itself: registering a hash is a global, process-wide side effect,
+// and most callers of sz only want the explicit Sha256/HashSha256 API.
+package szsha256register
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"hash"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+func init() {
+	crypto.RegisterHash(crypto.SHA256, func() hash.Hash { return sz.NewSha256() })
+}
+
+// NewHMAC returns a new HMAC-SHA256 hash.Hash using the given key, backed by
+// StringZilla's accelerated Sha256.
+func NewHMAC(key []byte) hash.Hash {
+	return hmac.New(func() hash.Hash { return sz.NewSha256() }, key)
+}
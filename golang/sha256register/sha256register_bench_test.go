@@ -0,0 +1,57 @@
+package szsha256register_test
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// Benchmarks compare the accelerated Sha256 against crypto/sha256 at a few
+// representative sizes: a small payload typical of JWT/JSON bodies, a medium
+// one typical of file chunks, and a large one typical of whole-file hashing.
+func benchmarkSizes() []int { return []int{4 * 1024, 64 * 1024, 1024 * 1024} }
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.New(rand.NewSource(1)).Read(b)
+	return b
+}
+
+func BenchmarkSha256Stdlib(b *testing.B) {
+	for _, size := range benchmarkSizes() {
+		data := randomBytes(size)
+		b.Run(sizeLabel(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				sha256.Sum256(data)
+			}
+		})
+	}
+}
+
+func BenchmarkSha256StringZilla(b *testing.B) {
+	for _, size := range benchmarkSizes() {
+		data := randomBytes(size)
+		b.Run(sizeLabel(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				sz.HashSha256(data)
+			}
+		})
+	}
+}
+
+func sizeLabel(size int) string {
+	switch size {
+	case 4 * 1024:
+		return "4KiB"
+	case 64 * 1024:
+		return "64KiB"
+	case 1024 * 1024:
+		return "1MiB"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,64 @@
+package szsha256register_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	szsha256register "github.com/ashvardanian/stringzilla/golang/sha256register"
+)
+
+func TestCryptoRegisterHash(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	want := sha256.Sum256(data)
+
+	h := crypto.SHA256.New()
+	h.Write(data)
+	var got [32]byte
+	copy(got[:], h.Sum(nil))
+
+	if got != want {
+		t.Errorf("crypto.SHA256.New() digest = %x, want %x", got, want)
+	}
+}
+
+func TestNewHMAC(t *testing.T) {
+	key := []byte("secret")
+	data := []byte("message")
+
+	want := hmac.New(sha256.New, key)
+	want.Write(data)
+
+	got := szsha256register.NewHMAC(key)
+	got.Write(data)
+
+	if !bytes.Equal(got.Sum(nil), want.Sum(nil)) {
+		t.Errorf("NewHMAC digest = %x, want %x", got.Sum(nil), want.Sum(nil))
+	}
+}
+
+func TestSumFile(t *testing.T) {
+	data := []byte("contents used to verify SumFile streams correctly\n")
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := szsha256register.SumFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sha256.Sum256(data); got != want {
+		t.Errorf("SumFile digest = %x, want %x", got, want)
+	}
+}
+
+func TestSumFileMissing(t *testing.T) {
+	if _, err := szsha256register.SumFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
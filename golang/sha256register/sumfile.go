@@ -0,0 +1,31 @@
+package szsha256register
+
+import (
+	"io"
+	"os"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// sumFileBufferSize is tuned larger than io.Copy's default 32 KiB buffer so
+// the accelerated hasher sees fewer, bigger Write calls, which matters more
+// for a SIMD kernel than it does for the stdlib's software implementation.
+const sumFileBufferSize = 256 * 1024
+
+// SumFile computes the SHA-256 digest of the file at path, streaming it
+// through StringZilla's accelerated hasher in sumFileBufferSize chunks
+// rather than reading the whole file into memory.
+func SumFile(path string) ([32]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	defer f.Close()
+
+	h := sz.NewSha256()
+	buf := make([]byte, sumFileBufferSize)
+	if _, err := io.CopyBuffer(h, f, buf); err != nil {
+		return [32]byte{}, err
+	}
+	return h.Digest(), nil
+}
@@ -0,0 +1,165 @@
+package sz
+
+import (
+	"iter"
+	"strings"
+	"unicode/utf8"
+)
+
+// explode splits s into individual UTF-8 runes, capped at n pieces (n < 0
+// means unlimited). It backs the sep == "" case of Split/SplitN, mirroring
+// strings.explode.
+func explode(s string, n int) []string {
+	l := utf8.RuneCountInString(s)
+	if n < 0 || n > l {
+		n = l
+	}
+	out := make([]string, n)
+	for i := 0; i < n-1; i++ {
+		_, size := utf8.DecodeRuneInString(s)
+		out[i] = s[:size]
+		s = s[size:]
+	}
+	if n > 0 {
+		out[n-1] = s
+	}
+	return out
+}
+
+// genSplit is the shared implementation behind Split, SplitN, and SplitAfter,
+// mirroring strings.genSplit. sepSave is 0 for Split/SplitN (the separator is
+// dropped) and len(sep) for SplitAfter (the separator stays attached to the
+// preceding piece).
+func genSplit(s, sep string, sepSave, n int) []string {
+	if n == 0 {
+		return nil
+	}
+	if sep == "" {
+		return explode(s, n)
+	}
+	if n < 0 {
+		n = int(Count(s, sep, false)) + 1
+	}
+	if n > len(s)+1 {
+		n = len(s) + 1
+	}
+
+	out := make([]string, 0, n)
+	for i := 0; i < n-1; i++ {
+		pos := Index(s, sep)
+		if pos < 0 {
+			break
+		}
+		end := int(pos) + sepSave
+		out = append(out, s[:end])
+		s = s[int(pos)+len(sep):]
+	}
+	out = append(out, s)
+	return out
+}
+
+// Split slices s into all substrings separated by sep, scanning with
+// sz_find instead of re-preprocessing the separator on every call.
+// If sep is empty, Split splits after each UTF-8 sequence.
+// https://pkg.go.dev/strings#Split
+func Split(s, sep string) []string { return genSplit(s, sep, 0, -1) }
+
+// SplitN slices s into substrings separated by sep, same as Split but
+// stopping after at most n substrings. n < 0 means no limit, n == 0 returns
+// nil.
+// https://pkg.go.dev/strings#SplitN
+func SplitN(s, sep string, n int) []string { return genSplit(s, sep, 0, n) }
+
+// SplitAfter slices s into all substrings after each instance of sep, with
+// sep itself attached to the end of each substring (except possibly the
+// last).
+// https://pkg.go.dev/strings#SplitAfter
+func SplitAfter(s, sep string) []string { return genSplit(s, sep, len(sep), -1) }
+
+// SplitSeq returns an iterator over the substrings of s separated by sep,
+// matching the semantics of Split but never materializing the full slice.
+// This is the payoff over Split on multi-GB inputs: callers can stop the
+// range early without paying for segments they never look at.
+// https://pkg.go.dev/strings#SplitSeq
+func SplitSeq(s, sep string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if sep == "" {
+			for len(s) > 0 {
+				_, size := utf8.DecodeRuneInString(s)
+				if !yield(s[:size]) {
+					return
+				}
+				s = s[size:]
+			}
+			return
+		}
+		for {
+			pos := Index(s, sep)
+			if pos < 0 {
+				yield(s)
+				return
+			}
+			if !yield(s[:pos]) {
+				return
+			}
+			s = s[int(pos)+len(sep):]
+		}
+	}
+}
+
+// asciiSpace lists the bytes genSplit's Fields fast path treats as whitespace:
+// space, tab, LF, CR, VT, FF, matching the ASCII subset of unicode.IsSpace.
+const asciiSpace = " \t\n\r\v\f"
+
+func isASCIISpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	default:
+		return false
+	}
+}
+
+// Fields splits s around runs of whitespace, returning a slice of the
+// substrings between them, or an empty slice if s contains only whitespace.
+// For pure-ASCII input it scans with IndexAny over a compiled whitespace
+// set, one cgo call per field; as soon as a non-ASCII byte is seen it falls
+// back to strings.Fields for full Unicode correctness.
+// https://pkg.go.dev/strings#Fields
+func Fields(s string) []string {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return fieldsUnicode(s)
+		}
+	}
+	return fieldsASCII(s)
+}
+
+func fieldsASCII(s string) []string {
+	var out []string
+	i := 0
+	for i < len(s) {
+		for i < len(s) && isASCIISpace(s[i]) {
+			i++
+		}
+		if i >= len(s) {
+			break
+		}
+		start := i
+		rel := IndexAny(s[i:], asciiSpace)
+		if rel < 0 {
+			out = append(out, s[start:])
+			break
+		}
+		i = start + int(rel)
+		out = append(out, s[start:i])
+	}
+	return out
+}
+
+// fieldsUnicode handles the rare non-ASCII case by deferring to strings.Fields,
+// which already implements full unicode.IsSpace semantics; StringZilla's
+// byte-set scan has no notion of codepoints.
+func fieldsUnicode(s string) []string {
+	return strings.Fields(s)
+}
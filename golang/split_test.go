@@ -0,0 +1,92 @@
+package sz_test
+
+import (
+	"strings"
+	"testing"
+
+	sz "github.com/ashvardanian/stringzilla/golang"
+)
+
+// TestSplitMatchesStrings cross-checks Split/SplitN/SplitAfter against the
+// standard library for a range of separators and limits.
+func TestSplitMatchesStrings(t *testing.T) {
+	tests := []struct {
+		s, sep string
+		n      int
+	}{
+		{"a,b,c", ",", -1},
+		{"a,b,c", ",", 0},
+		{"a,b,c", ",", 2},
+		{"a,b,c", ",", 10},
+		{"", ",", -1},
+		{",", ",", -1},
+		{"abc", "", -1},
+		{"héllo", "", -1},
+	}
+
+	for _, tt := range tests {
+		if got, want := sz.Split(tt.s, tt.sep), strings.Split(tt.s, tt.sep); !equalSlices(got, want) {
+			t.Errorf("Split(%q, %q) = %v, want %v", tt.s, tt.sep, got, want)
+		}
+		if got, want := sz.SplitN(tt.s, tt.sep, tt.n), strings.SplitN(tt.s, tt.sep, tt.n); !equalSlices(got, want) {
+			t.Errorf("SplitN(%q, %q, %d) = %v, want %v", tt.s, tt.sep, tt.n, got, want)
+		}
+		if got, want := sz.SplitAfter(tt.s, tt.sep), strings.SplitAfter(tt.s, tt.sep); !equalSlices(got, want) {
+			t.Errorf("SplitAfter(%q, %q) = %v, want %v", tt.s, tt.sep, got, want)
+		}
+	}
+}
+
+// TestFields cross-checks Fields against strings.Fields for both ASCII and
+// Unicode whitespace.
+func TestFields(t *testing.T) {
+	tests := []string{
+		"  foo bar  baz   ",
+		"",
+		"   ",
+		"one",
+		"a\tb\nc\r\nd",
+		"foo bar", // non-breaking space, exercises the Unicode fallback
+	}
+
+	for _, s := range tests {
+		if got, want := sz.Fields(s), strings.Fields(s); !equalSlices(got, want) {
+			t.Errorf("Fields(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+// TestSplitSeq verifies the iterator yields the same substrings as Split and
+// that the caller can stop early.
+func TestSplitSeq(t *testing.T) {
+	var got []string
+	for part := range sz.SplitSeq("a,b,c,d", ",") {
+		got = append(got, part)
+	}
+	if want := sz.Split("a,b,c,d", ","); !equalSlices(got, want) {
+		t.Errorf("SplitSeq collected %v, want %v", got, want)
+	}
+
+	got = nil
+	for part := range sz.SplitSeq("a,b,c,d", ",") {
+		got = append(got, part)
+		if part == "b" {
+			break
+		}
+	}
+	if want := []string{"a", "b"}; !equalSlices(got, want) {
+		t.Errorf("SplitSeq early break collected %v, want %v", got, want)
+	}
+}
+
+func equalSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}